@@ -0,0 +1,35 @@
+// Copyright 2016 - 2021 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+//
+// Package excelize providing a set of functions that allow you to write to
+// and read from XLSX files. Support reads and writes XLSX file generated by
+// Microsoft Excel™ 2007 and later. Support save file without losing original
+// charts of XLSX. This library needs Go version 1.15 or later.
+
+package excelize
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypt(t *testing.T) {
+	f, err := OpenFile(filepath.Join("test", "encryptSHA1.xlsx"), Options{Password: "password"})
+	assert.NoError(t, err)
+	assert.EqualError(t, f.SaveAs(filepath.Join("test", "BadEncrypt.xlsx"), Options{Password: "password"}), "not support encryption currently")
+}
+
+func TestEncryptionMechanism(t *testing.T) {
+	mechanism, err := encryptionMechanism([]byte{3, 0, 3, 0})
+	assert.Equal(t, mechanism, "extensible")
+	assert.EqualError(t, err, "unsupport encryption mechanism")
+	_, err = encryptionMechanism([]byte{})
+	assert.EqualError(t, err, "unknown encryption mechanism")
+}
+
+func TestHashing(t *testing.T) {
+	assert.Equal(t, hashing("unsupportHashAlgorithm", []byte{}), []uint8([]byte(nil)))
+}
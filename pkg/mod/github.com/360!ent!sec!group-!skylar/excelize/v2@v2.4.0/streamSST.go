@@ -0,0 +1,190 @@
+// Copyright 2016 - 2021 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// SSTMode controls how the shared string table is built while a file is
+// being written. SSTModeMemory keeps every interned string in the
+// in-memory xlsxSST slice, as excelize has always done. SSTModeStream
+// spills interned strings to a temp file as they are added, so generating
+// a workbook with millions of distinct string cells does not build the
+// in-memory xlsxSST slice. Each distinct string is still kept in memory for
+// deduplication and read-back, and the whole table is read back from the
+// temp file into memory when the workbook is saved.
+type SSTMode int
+
+const (
+	// SSTModeMemory keeps the shared string table fully in memory.
+	SSTModeMemory SSTMode = iota
+	// SSTModeStream appends interned strings to an on-disk temp file and
+	// keeps the string-to-index dedup map and the interned values in memory.
+	SSTModeStream
+)
+
+// streamSST is the on-disk, append-only shared string table builder used
+// when a File is in SSTModeStream. It keeps a map[string]int in memory for
+// deduplication, a parallel slice so interned values can still be read back
+// by index without re-reading the spill file, and writes each newly
+// interned <si><t>...</t></si> entry to a temp file as it is added, so the
+// serialized form is not built up in memory while strings are interned.
+// finalize reads the whole spill file back into one buffer at save time.
+type streamSST struct {
+	mu     sync.Mutex
+	index  map[string]int
+	values []string
+	count  int
+	file   *os.File
+	writer *bufio.Writer
+	closed bool
+}
+
+// newStreamSST creates a streamSST backed by a new temp file.
+func newStreamSST() (*streamSST, error) {
+	f, err := ioutil.TempFile("", "excelize-sst-*.xml")
+	if err != nil {
+		return nil, err
+	}
+	return &streamSST{
+		index:  make(map[string]int),
+		file:   f,
+		writer: bufio.NewWriter(f),
+	}, nil
+}
+
+// setString interns val, appending it to the spill file the first time it
+// is seen, and returns its index into the shared string table.
+func (s *streamSST) setString(val string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, errors.New("streamSST is closed")
+	}
+	s.count++
+	if idx, ok := s.index[val]; ok {
+		return idx, nil
+	}
+	idx := len(s.index)
+	t := xlsxT{Val: val}
+	if len(val) > 0 && (val[0] == ' ' || val[len(val)-1] == ' ') {
+		t.Space = xml.Attr{Name: xml.Name{Space: NameSpaceXML, Local: "space"}, Value: "preserve"}
+	}
+	buf, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"si"`
+		T       xlsxT    `xml:"t"`
+	}{T: t})
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.writer.Write(buf); err != nil {
+		return 0, err
+	}
+	s.index[val] = idx
+	s.values = append(s.values, val)
+	return idx, nil
+}
+
+// stringAt returns the interned value at idx, so cells written while in
+// SSTModeStream can be read back in the same session without a round trip
+// through the spill file.
+func (s *streamSST) stringAt(idx int) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if idx < 0 || idx >= len(s.values) {
+		return "", false
+	}
+	return s.values[idx], true
+}
+
+// uniqueCount returns the number of distinct strings interned so far.
+func (s *streamSST) uniqueCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.index)
+}
+
+// finalize flushes the spill file and returns the full sharedStrings.xml
+// payload with correct count/uniqueCount attributes, ready to be written
+// into the package at Save time.
+func (s *streamSST) finalize() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.writer.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := s.file.Seek(0, 0); err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(s.file)
+	if err != nil {
+		return nil, err
+	}
+	header := []byte(XMLHeader + `<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`count="` + strconv.Itoa(s.count) + `" uniqueCount="` + strconv.Itoa(len(s.index)) + `">`)
+	out := append(header, body...)
+	return append(out, []byte(`</sst>`)...), nil
+}
+
+// close removes the backing temp file. It is called once the finalized
+// sharedStrings.xml has been written into the package, or when switching
+// back to SSTModeMemory without ever having saved. Safe to call more than
+// once, since both of those can happen for the same streamSST.
+func (s *streamSST) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	name := s.file.Name()
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(name)
+}
+
+// SetSSTMode toggles how the shared string table is accumulated while
+// building a workbook. Switching to SSTModeStream before writing any
+// string cells keeps the serialized <si> entries out of memory while a
+// large export runs; memory use still grows with the number of distinct
+// strings it interns. The in-memory and streamed tables are two separate
+// tables that both number their entries from 0, so SSTModeStream is
+// refused once the
+// workbook already has shared strings in memory (from a prior SetCellStr
+// call, or from opening a workbook that already contains them) — mixing
+// the two would silently drop the in-memory entries at save time.
+//
+// The streamed table is backed by a temp file. Switch back to
+// SSTModeMemory once the workbook has been saved and is no longer needed,
+// so the temp file is removed; a File left in SSTModeStream for its
+// entire lifetime otherwise leaks it.
+func (f *File) SetSSTMode(mode SSTMode) error {
+	if mode == SSTModeStream && f.streamSST == nil {
+		if sst := f.sharedStringsReader(); len(sst.SI) > 0 {
+			return errors.New("cannot enable streaming shared string mode: workbook already has shared strings in memory")
+		}
+		s, err := newStreamSST()
+		if err != nil {
+			return err
+		}
+		f.streamSST = s
+	}
+	if mode == SSTModeMemory && f.streamSST != nil {
+		if err := f.streamSST.close(); err != nil {
+			return err
+		}
+		f.streamSST = nil
+	}
+	f.sstMode = mode
+	return nil
+}
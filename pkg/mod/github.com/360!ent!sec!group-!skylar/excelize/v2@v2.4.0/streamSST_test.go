@@ -0,0 +1,142 @@
+// Copyright 2016 - 2021 The excelize Authors. All rights reserved. Use of
+// this source code is governed by a BSD-style license that can be found in
+// the LICENSE file.
+
+package excelize
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readZipFile extracts the content of a single file from a zip buffer, used
+// to inspect xl/sharedStrings.xml without doing a full OpenReader round-trip.
+func readZipFile(t *testing.T, buf *bytes.Buffer, name string) string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	for _, f := range zr.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			assert.NoError(t, err)
+			defer rc.Close()
+			content, err := ioutil.ReadAll(rc)
+			assert.NoError(t, err)
+			return string(content)
+		}
+	}
+	t.Fatalf("%s not found in zip", name)
+	return ""
+}
+
+func TestStreamSST(t *testing.T) {
+	s, err := newStreamSST()
+	assert.NoError(t, err)
+	defer s.close()
+
+	idx1, err := s.setString("foo")
+	assert.NoError(t, err)
+	idx2, err := s.setString("bar")
+	assert.NoError(t, err)
+	idx3, err := s.setString("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, idx1, idx3)
+	assert.NotEqual(t, idx1, idx2)
+	assert.Equal(t, 2, s.uniqueCount())
+
+	out, err := s.finalize()
+	assert.NoError(t, err)
+	assert.Contains(t, string(out), `uniqueCount="2"`)
+	assert.Contains(t, string(out), `count="3"`)
+	assert.Contains(t, string(out), "<t>foo</t>")
+}
+
+func TestSetSSTModeSetCellStr(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSSTMode(SSTModeStream))
+	assert.NotNil(t, f.streamSST)
+
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "streamed value"))
+	assert.NoError(t, f.SetCellStr("Sheet1", "A2", "streamed value"))
+	assert.Equal(t, 1, f.streamSST.uniqueCount())
+
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	sst := readZipFile(t, buf, "xl/sharedStrings.xml")
+	assert.Contains(t, sst, "streamed value")
+	assert.Contains(t, sst, `uniqueCount="1"`)
+	assert.Contains(t, sst, `count="2"`)
+
+	assert.NoError(t, f.SetSSTMode(SSTModeMemory))
+	assert.Nil(t, f.streamSST)
+}
+
+// TestSetSSTModeRefusesMixedMode confirms that switching to SSTModeStream
+// once the workbook already has shared strings in memory is refused,
+// instead of silently dropping the pre-existing strings at save time.
+func TestSetSSTModeRefusesMixedMode(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "pre-existing value"))
+	assert.Error(t, f.SetSSTMode(SSTModeStream))
+	assert.Nil(t, f.streamSST)
+	assert.Equal(t, SSTModeMemory, f.sstMode)
+
+	// The pre-existing value must still be intact and readable.
+	value, err := f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "pre-existing value", value)
+}
+
+// TestGetCellValueStreamMode confirms a cell written while the workbook is
+// in SSTModeStream reads back its text in the same session, rather than
+// the raw shared-string index that was only ever resolvable against the
+// in-memory table.
+func TestGetCellValueStreamMode(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSSTMode(SSTModeStream))
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "streamed value"))
+
+	value, err := f.GetCellValue("Sheet1", "A1")
+	assert.NoError(t, err)
+	assert.Equal(t, "streamed value", value)
+	assert.NoError(t, f.SetSSTMode(SSTModeMemory))
+}
+
+// TestStreamSSTTempFileCleanup confirms the spill file backing a streamSST
+// is removed once the caller switches back to SSTModeMemory.
+func TestStreamSSTTempFileCleanup(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSSTMode(SSTModeStream))
+	assert.NoError(t, f.SetCellStr("Sheet1", "A1", "streamed value"))
+	_, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+
+	path := f.streamSST.file.Name()
+	assert.NoError(t, f.SetSSTMode(SSTModeMemory))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestStreamWriterSSTMode(t *testing.T) {
+	f := NewFile()
+	assert.NoError(t, f.SetSSTMode(SSTModeStream))
+	sw, err := f.NewStreamWriter("Sheet1")
+	assert.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		axis, err := CoordinatesToCellName(1, i)
+		assert.NoError(t, err)
+		assert.NoError(t, sw.SetRow(axis, []interface{}{"repeated"}))
+	}
+	assert.NoError(t, sw.Flush())
+	assert.Equal(t, 1, f.streamSST.uniqueCount())
+
+	buf, err := f.WriteToBuffer()
+	assert.NoError(t, err)
+	assert.Contains(t, readZipFile(t, buf, "xl/sharedStrings.xml"), "repeated")
+	assert.NoError(t, f.SetSSTMode(SSTModeMemory))
+}